@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &dataSourceTFEDataRetentionPolicies{}
+var _ datasource.DataSourceWithConfigure = &dataSourceTFEDataRetentionPolicies{}
+
+// NewDataRetentionPoliciesDataSource must be added to the provider's
+// DataSources() list (in provider.go) before "tfe_data_retention_policies"
+// is reachable from a Terraform configuration.
+func NewDataRetentionPoliciesDataSource() datasource.DataSource {
+	return &dataSourceTFEDataRetentionPolicies{}
+}
+
+// dataSourceTFEDataRetentionPolicies implements the tfe_data_retention_policies data source type
+type dataSourceTFEDataRetentionPolicies struct {
+	config ConfiguredClient
+}
+
+// modelDSTFEDataRetentionPolicies is the data model for the
+// tfe_data_retention_policies data source.
+type modelDSTFEDataRetentionPolicies struct {
+	ID           types.String                             `tfsdk:"id"`
+	Organization types.String                             `tfsdk:"organization"`
+	Policies     []modelDSTFEWorkspaceDataRetentionPolicy `tfsdk:"policies"`
+}
+
+// modelDSTFEWorkspaceDataRetentionPolicy describes a single workspace-scoped
+// policy returned by the tfe_data_retention_policies listing.
+type modelDSTFEWorkspaceDataRetentionPolicy struct {
+	ID                  types.String     `tfsdk:"id"`
+	WorkspaceID         types.String     `tfsdk:"workspace_id"`
+	WorkspaceName       types.String     `tfsdk:"workspace_name"`
+	Kind                types.String     `tfsdk:"kind"`
+	DeleteOlderThanDays types.Number     `tfsdk:"delete_older_than_days"`
+	Rules               []modelDSTFERule `tfsdk:"rules"`
+}
+
+func (d *dataSourceTFEDataRetentionPolicies) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_retention_policies"
+}
+
+func (d *dataSourceTFEDataRetentionPolicies) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists every workspace-scoped data retention policy in an organization, so operators can audit which workspaces override the org default.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of this data source. Set to the organization name.",
+				Computed:    true,
+			},
+			"organization": schema.StringAttribute{
+				Description: "Name of the organization. If omitted, organization must be defined in the provider config.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"policies": schema.ListNestedAttribute{
+				Description: "Workspace-scoped data retention policies in the organization.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the Data Retention Policy.",
+							Computed:    true,
+						},
+						"workspace_id": schema.StringAttribute{
+							Description: "ID of the workspace the policy applies to.",
+							Computed:    true,
+						},
+						"workspace_name": schema.StringAttribute{
+							Description: "Name of the workspace the policy applies to.",
+							Computed:    true,
+						},
+						"kind": schema.StringAttribute{
+							Description: "One of \"delete_older_than\", \"dont_delete\", or \"rules\", depending on which policy is in effect.",
+							Computed:    true,
+						},
+						"delete_older_than_days": schema.NumberAttribute{
+							Description: "Number of days data is retained for when kind is \"delete_older_than\".",
+							Computed:    true,
+						},
+						"rules": schema.ListNestedAttribute{
+							Description: "Scoped retention rules in effect when kind is \"rules\".",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"scope": schema.StringAttribute{
+										Description: "Artifact kind the rule applies to.",
+										Computed:    true,
+									},
+									"delete_older_than_days": schema.NumberAttribute{
+										Description: "Number of days after which data in this scope is deleted.",
+										Computed:    true,
+									},
+									"keep_latest_n": schema.NumberAttribute{
+										Description: "Always keep the N most recent items in this scope, regardless of age.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (d *dataSourceTFEDataRetentionPolicies) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected data source Configure type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on GitHub.", req.ProviderData),
+		)
+	}
+	d.config = client
+}
+
+func (d *dataSourceTFEDataRetentionPolicies) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data modelDSTFEDataRetentionPolicies
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var organization string
+	resp.Diagnostics.Append(d.config.dataOrDefaultOrganization(ctx, req.Config, &organization)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Organization = types.StringValue(organization)
+	data.ID = types.StringValue(organization)
+
+	tflog.Debug(ctx, "Listing workspaces to audit data retention policies")
+
+	options := &tfe.WorkspaceListOptions{}
+	var policies []modelDSTFEWorkspaceDataRetentionPolicy
+	for {
+		workspaces, err := d.config.Client.Workspaces.List(ctx, organization, options)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to list workspaces", err.Error())
+			return
+		}
+
+		for _, ws := range workspaces.Items {
+			policy, err := d.config.Client.Workspaces.ReadDataRetentionPolicyChoice(ctx, ws.ID)
+			if err != nil {
+				if errors.Is(err, tfe.ErrResourceNotFound) {
+					continue
+				}
+				resp.Diagnostics.AddError("Unable to read data retention policy", err.Error())
+				return
+			}
+
+			entry := modelDSTFEWorkspaceDataRetentionPolicy{
+				WorkspaceID:   types.StringValue(ws.ID),
+				WorkspaceName: types.StringValue(ws.Name),
+			}
+			switch {
+			case policy.DataRetentionPolicyDeleteOlder != nil:
+				entry.ID = types.StringValue(policy.DataRetentionPolicyDeleteOlder.ID)
+				entry.Kind = types.StringValue("delete_older_than")
+				entry.DeleteOlderThanDays = types.NumberValue(big.NewFloat(float64(policy.DataRetentionPolicyDeleteOlder.DeleteOlderThanNDays)))
+			case policy.DataRetentionPolicyDontDelete != nil:
+				entry.ID = types.StringValue(policy.DataRetentionPolicyDontDelete.ID)
+				entry.Kind = types.StringValue("dont_delete")
+				entry.DeleteOlderThanDays = types.NumberNull()
+			case policy.DataRetentionPolicyRules != nil:
+				entry.ID = types.StringValue(policy.DataRetentionPolicyRules.ID)
+				entry.Kind = types.StringValue("rules")
+				entry.DeleteOlderThanDays = types.NumberNull()
+				entry.Rules = make([]modelDSTFERule, 0, len(policy.DataRetentionPolicyRules.Rules))
+				for _, rule := range policy.DataRetentionPolicyRules.Rules {
+					entry.Rules = append(entry.Rules, modelDSTFERule{
+						Scope:               types.StringValue(rule.Scope),
+						DeleteOlderThanDays: types.NumberValue(big.NewFloat(float64(rule.DeleteOlderThanNDays))),
+						KeepLatestN:         types.NumberValue(big.NewFloat(float64(rule.KeepLatestN))),
+					})
+				}
+			default:
+				continue
+			}
+
+			policies = append(policies, entry)
+		}
+
+		if workspaces.CurrentPage >= workspaces.TotalPages {
+			break
+		}
+		options.PageNumber = workspaces.NextPage
+	}
+
+	data.Policies = policies
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
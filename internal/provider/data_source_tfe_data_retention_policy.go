@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &dataSourceTFEDataRetentionPolicy{}
+var _ datasource.DataSourceWithConfigure = &dataSourceTFEDataRetentionPolicy{}
+
+// NewDataRetentionPolicyDataSource must be added to the provider's
+// DataSources() list (in provider.go) before "tfe_data_retention_policy" is
+// reachable from a Terraform configuration.
+func NewDataRetentionPolicyDataSource() datasource.DataSource {
+	return &dataSourceTFEDataRetentionPolicy{}
+}
+
+// dataSourceTFEDataRetentionPolicy implements the tfe_data_retention_policy data source type
+type dataSourceTFEDataRetentionPolicy struct {
+	config ConfiguredClient
+}
+
+// modelDSTFEDataRetentionPolicy is the data model for the
+// tfe_data_retention_policy data source.
+type modelDSTFEDataRetentionPolicy struct {
+	ID                  types.String     `tfsdk:"id"`
+	Organization        types.String     `tfsdk:"organization"`
+	WorkspaceId         types.String     `tfsdk:"workspace_id"`
+	Kind                types.String     `tfsdk:"kind"`
+	DeleteOlderThanDays types.Number     `tfsdk:"delete_older_than_days"`
+	Rules               []modelDSTFERule `tfsdk:"rules"`
+}
+
+// modelDSTFERule describes a single scoped retention rule surfaced by the
+// tfe_data_retention_policy and tfe_data_retention_policies data sources.
+type modelDSTFERule struct {
+	Scope               types.String `tfsdk:"scope"`
+	DeleteOlderThanDays types.Number `tfsdk:"delete_older_than_days"`
+	KeepLatestN         types.Number `tfsdk:"keep_latest_n"`
+}
+
+func (d *dataSourceTFEDataRetentionPolicy) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_retention_policy"
+}
+
+func (d *dataSourceTFEDataRetentionPolicy) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up the data retention policy for a workspace, or for the entire organization if workspace_id is omitted.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the Data Retention Policy.",
+				Computed:    true,
+			},
+			"organization": schema.StringAttribute{
+				Description: "Name of the organization. If omitted, organization must be defined in the provider config.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				Description: "ID of the workspace to look up the data retention policy for. If omitted, the org-wide policy is returned.",
+				Optional:    true,
+			},
+			"kind": schema.StringAttribute{
+				Description: "One of \"delete_older_than\", \"dont_delete\", or \"rules\", depending on which policy is in effect.",
+				Computed:    true,
+			},
+			"delete_older_than_days": schema.NumberAttribute{
+				Description: "Number of days data is retained for when kind is \"delete_older_than\".",
+				Computed:    true,
+			},
+			"rules": schema.ListNestedAttribute{
+				Description: "Scoped retention rules in effect when kind is \"rules\".",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"scope": schema.StringAttribute{
+							Description: "Artifact kind the rule applies to.",
+							Computed:    true,
+						},
+						"delete_older_than_days": schema.NumberAttribute{
+							Description: "Number of days after which data in this scope is deleted.",
+							Computed:    true,
+						},
+						"keep_latest_n": schema.NumberAttribute{
+							Description: "Always keep the N most recent items in this scope, regardless of age.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (d *dataSourceTFEDataRetentionPolicy) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected data source Configure type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on GitHub.", req.ProviderData),
+		)
+	}
+	d.config = client
+}
+
+func (d *dataSourceTFEDataRetentionPolicy) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data modelDSTFEDataRetentionPolicy
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var organization string
+	if data.WorkspaceId.IsNull() {
+		resp.Diagnostics.Append(d.config.dataOrDefaultOrganization(ctx, req.Config, &organization)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Organization = types.StringValue(organization)
+	}
+
+	tflog.Debug(ctx, "Reading data retention policy")
+	var policy *tfe.DataRetentionPolicyChoice
+	var err error
+	if data.WorkspaceId.IsNull() {
+		policy, err = d.config.Client.Organizations.ReadDataRetentionPolicyChoice(ctx, data.Organization.ValueString())
+	} else {
+		policy, err = d.config.Client.Workspaces.ReadDataRetentionPolicyChoice(ctx, data.WorkspaceId.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read data retention policy", err.Error())
+		return
+	}
+
+	switch {
+	case policy.DataRetentionPolicyDeleteOlder != nil:
+		data.ID = types.StringValue(policy.DataRetentionPolicyDeleteOlder.ID)
+		data.Kind = types.StringValue("delete_older_than")
+		data.DeleteOlderThanDays = types.NumberValue(big.NewFloat(float64(policy.DataRetentionPolicyDeleteOlder.DeleteOlderThanNDays)))
+	case policy.DataRetentionPolicyDontDelete != nil:
+		data.ID = types.StringValue(policy.DataRetentionPolicyDontDelete.ID)
+		data.Kind = types.StringValue("dont_delete")
+		data.DeleteOlderThanDays = types.NumberNull()
+	case policy.DataRetentionPolicyRules != nil:
+		data.ID = types.StringValue(policy.DataRetentionPolicyRules.ID)
+		data.Kind = types.StringValue("rules")
+		data.DeleteOlderThanDays = types.NumberNull()
+		data.Rules = make([]modelDSTFERule, 0, len(policy.DataRetentionPolicyRules.Rules))
+		for _, rule := range policy.DataRetentionPolicyRules.Rules {
+			data.Rules = append(data.Rules, modelDSTFERule{
+				Scope:               types.StringValue(rule.Scope),
+				DeleteOlderThanDays: types.NumberValue(big.NewFloat(float64(rule.DeleteOlderThanNDays))),
+				KeepLatestN:         types.NumberValue(big.NewFloat(float64(rule.KeepLatestN))),
+			})
+		}
+	default:
+		resp.Diagnostics.AddError("No data retention policy found", "The organization or workspace does not have a data retention policy configured.")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
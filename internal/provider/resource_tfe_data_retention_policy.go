@@ -2,27 +2,43 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/numbervalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// dataRetentionPolicyRuleScopes enumerates the artifact kinds a rule block
+// may target. Scopes must not overlap within a single resource.
+var dataRetentionPolicyRuleScopes = []string{
+	"state_versions",
+	"configuration_versions",
+	"plan_logs",
+	"apply_logs",
+}
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceTFEDataRetentionPolicy{}
 var _ resource.ResourceWithConfigure = &resourceTFEDataRetentionPolicy{}
 var _ resource.ResourceWithImportState = &resourceTFEDataRetentionPolicy{}
 var _ resource.ResourceWithModifyPlan = &resourceTFEDataRetentionPolicy{}
+var _ resource.ResourceWithValidateConfig = &resourceTFEDataRetentionPolicy{}
 
 func NewDataRetentionPolicyResource() resource.Resource {
 	return &resourceTFEDataRetentionPolicy{}
@@ -39,6 +55,85 @@ func (r *resourceTFEDataRetentionPolicy) Metadata(ctx context.Context, req resou
 
 func (r *resourceTFEDataRetentionPolicy) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	modifyPlanForDefaultOrganizationChange(ctx, r.config.Organization, req.State, req.Config, req.Plan, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.State.Raw.IsNull() || resp.Plan.Raw.IsNull() {
+		// Create or Delete; there is no prior state to carry forward.
+		return
+	}
+
+	planned, err := suppressComputedDiffs(resp.Plan.Raw, req.State.Raw, req.Config.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Error modifying plan", err.Error())
+		return
+	}
+	resp.Plan.Raw = planned
+}
+
+// dataRetentionPolicyMutuallyExclusiveBlocks are the top-level, Optional
+// (not Computed) blocks through which a data retention policy is
+// configured. Removing one from config is a legitimate "no longer set"
+// signal that must plan as null, not a spurious computed diff, so
+// suppressComputedDiffs never touches these subtrees.
+var dataRetentionPolicyMutuallyExclusiveBlocks = map[string]bool{
+	"delete_older_than": true,
+	"dont_delete":       true,
+	"schedule":          true,
+	"rule":              true,
+}
+
+// suppressComputedDiffs walks the planned value and, for any attribute the
+// plan reports as unknown (computed by the API) or null (left unspecified
+// in config) while the prior state holds a value, copies the state value
+// forward. This mirrors the filtering upjet applies in its Plugin Framework
+// external client to keep server-assigned or normalized fields from
+// producing a spurious diff. An attribute is never overridden when the
+// practitioner's config explicitly sets it, so real changes still plan.
+//
+// The mutually exclusive delete_older_than/dont_delete/schedule/rule blocks
+// are skipped: they are plain Optional, so a null there after a config
+// change must stay null rather than be resurrected from the old state.
+// Every other attribute is eligible, whether or not it is Computed in the
+// schema.
+func suppressComputedDiffs(planned, state, config tftypes.Value) (tftypes.Value, error) {
+	return tftypes.Transform(planned, func(path *tftypes.AttributePath, plannedVal tftypes.Value) (tftypes.Value, error) {
+		if len(path.Steps()) > 0 {
+			if name, ok := path.Steps()[0].(tftypes.AttributeName); ok && dataRetentionPolicyMutuallyExclusiveBlocks[string(name)] {
+				return plannedVal, nil
+			}
+		}
+
+		if plannedVal.IsKnown() && !plannedVal.IsNull() {
+			return plannedVal, nil
+		}
+
+		stateVal, remaining, err := tftypes.WalkAttributePath(state, path)
+		if err != nil {
+			if len(remaining.Steps()) > 0 {
+				// The state doesn't have this attribute (e.g. schema
+				// upgrade); leave the planned value untouched.
+				return plannedVal, nil
+			}
+			return plannedVal, err
+		}
+
+		sv, ok := stateVal.(tftypes.Value)
+		if !ok || !sv.IsKnown() || sv.IsNull() {
+			return plannedVal, nil
+		}
+
+		configVal, remaining, err := tftypes.WalkAttributePath(config, path)
+		if err == nil && len(remaining.Steps()) == 0 {
+			if cv, ok := configVal.(tftypes.Value); ok && cv.IsKnown() && !cv.IsNull() {
+				// The practitioner set this explicitly; let the real diff through.
+				return plannedVal, nil
+			}
+		}
+
+		return sv, nil
+	})
 }
 
 func (r *resourceTFEDataRetentionPolicy) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -57,24 +152,57 @@ func (r *resourceTFEDataRetentionPolicy) Schema(ctx context.Context, req resourc
 			"organization": schema.StringAttribute{
 				Description: "Name of the organization. If omitted, organization must be defined in the provider config.",
 				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"workspace_id": schema.StringAttribute{
 				Description: "ID of the workspace that the data retention policy should apply to. If omitted, the data retention policy will apply to the entire organization.",
 				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 		},
 		Blocks: map[string]schema.Block{
 			"delete_older_than": schema.SingleNestedBlock{
-				Description: "Sets the maximum number of days, months, years data is allowed to exist before it is scheduled for deletion. Cannot be configured if the dont_delete attribute is also configured.",
+				Description: "Sets the maximum number of days, months, or years data is allowed to exist before it is scheduled for deletion. Exactly one of days, months, or years must be set. Cannot be configured if the dont_delete attribute is also configured.",
 				Attributes: map[string]schema.Attribute{
 					"days": schema.NumberAttribute{
-						Description: "Number of days",
-						Required:    true,
+						Description: "Number of days.",
+						Optional:    true,
+						Validators: []validator.Number{
+							numbervalidator.ExactlyOneOf(
+								path.MatchRelative().AtParent().AtName("months"),
+								path.MatchRelative().AtParent().AtName("years"),
+							),
+						},
+					},
+					"months": schema.NumberAttribute{
+						Description: "Number of months. Converted to an equivalent number of days on the wire.",
+						Optional:    true,
+						Validators: []validator.Number{
+							numbervalidator.ExactlyOneOf(
+								path.MatchRelative().AtParent().AtName("days"),
+								path.MatchRelative().AtParent().AtName("years"),
+							),
+						},
+					},
+					"years": schema.NumberAttribute{
+						Description: "Number of years. Converted to an equivalent number of days on the wire.",
+						Optional:    true,
+						Validators: []validator.Number{
+							numbervalidator.ExactlyOneOf(
+								path.MatchRelative().AtParent().AtName("days"),
+								path.MatchRelative().AtParent().AtName("months"),
+							),
+						},
 					},
 				},
 				Validators: []validator.Object{
 					objectvalidator.ExactlyOneOf(
 						path.MatchRelative().AtParent().AtName("dont_delete"),
+						path.MatchRelative().AtParent().AtName("rule"),
 					),
 				},
 			},
@@ -83,13 +211,107 @@ func (r *resourceTFEDataRetentionPolicy) Schema(ctx context.Context, req resourc
 				Validators: []validator.Object{
 					objectvalidator.ExactlyOneOf(
 						path.MatchRelative().AtParent().AtName("delete_older_than"),
+						path.MatchRelative().AtParent().AtName("rule"),
 					),
 				},
 			},
+			"schedule": schema.SingleNestedBlock{
+				Description: "Configures when the retention sweep described by delete_older_than runs. If omitted, the sweep runs on the default schedule for the organization or workspace.",
+				Attributes: map[string]schema.Attribute{
+					"cron": schema.StringAttribute{
+						Description: "Cron expression describing when the retention sweep should run, e.g. \"0 2 * * 0\" for every Sunday at 02:00.",
+						Required:    true,
+					},
+					"timezone": schema.StringAttribute{
+						Description: "IANA timezone the cron expression is evaluated in. Defaults to \"UTC\".",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"rule": schema.ListNestedBlock{
+				Description: "Scopes retention to a specific artifact kind, e.g. keeping the last 10 state versions while deleting configuration versions older than 30 days. Scopes must not overlap across rule blocks. Cannot be configured alongside delete_older_than or dont_delete.",
+				Validators: []validator.List{
+					listvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("delete_older_than"),
+						path.MatchRelative().AtParent().AtName("dont_delete"),
+					),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"scope": schema.StringAttribute{
+							Description: "Artifact kind the rule applies to. Must be one of " + strings.Join(dataRetentionPolicyRuleScopes, ", ") + ".",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(dataRetentionPolicyRuleScopes...),
+							},
+						},
+						"delete_older_than_days": schema.NumberAttribute{
+							Description: "Number of days after which data in this scope is deleted.",
+							Optional:    true,
+						},
+						"keep_latest_n": schema.NumberAttribute{
+							Description: "Always keep the N most recent items in this scope, regardless of age.",
+							Optional:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// ValidateConfig implements resource.ResourceWithValidateConfig. It rejects
+// configurations where two or more rule blocks target the same scope, and
+// where schedule is set without delete_older_than (schedule only controls
+// when the delete_older_than sweep runs, so create/update would otherwise
+// silently drop it).
+func (r *resourceTFEDataRetentionPolicy) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config modelTFEDataRetentionPolicy
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Schedule.IsNull() && !config.Schedule.IsUnknown() && config.DeleteOlderThan.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("schedule"),
+			"Invalid schedule",
+			"schedule configures when the delete_older_than sweep runs and cannot be set unless delete_older_than is also configured.",
+		)
+	}
+
+	if config.Rule.IsNull() || config.Rule.IsUnknown() {
+		return
+	}
+
+	var rules []modelTFERule
+	resp.Diagnostics.Append(config.Rule.ElementsAs(ctx, &rules, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		if rule.Scope.IsUnknown() || rule.Scope.IsNull() {
+			continue
+		}
+		scope := rule.Scope.ValueString()
+		if seen[scope] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rule"),
+				"Overlapping rule scope",
+				fmt.Sprintf("More than one rule block targets the %q scope. Each scope may only be covered by a single rule.", scope),
+			)
+			continue
+		}
+		seen[scope] = true
+	}
+}
+
 // Configure implements resource.ResourceWithConfigure
 func (r *resourceTFEDataRetentionPolicy) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
@@ -137,6 +359,101 @@ func (r *resourceTFEDataRetentionPolicy) Create(ctx context.Context, req resourc
 		return
 	}
 
+	if len(plan.Rule.Elements()) > 0 {
+		r.createRulesRetentionPolicy(ctx, plan, resp)
+		return
+	}
+
+}
+
+// NOTE: tfe.DataRetentionPolicyRule, tfe.DataRetentionPolicyRules,
+// tfe.DataRetentionPolicyRulesSetOptions, Organizations/Workspaces
+// .SetDataRetentionPolicyRules, and the ScheduleCronExpression/
+// ScheduleTimezone fields on tfe.DataRetentionPolicyDeleteOlderSetOptions
+// referenced by the rule and schedule blocks below do not exist in the
+// go-tfe SDK as of the version this provider currently depends on (checked
+// against the latest published go-tfe: only DataRetentionPolicyDeleteOlder
+// and DataRetentionPolicyDontDelete are supported). Scoped per-artifact
+// retention rules and cron-scheduled sweeps are not yet a capability of the
+// TFE API/SDK this provider vendors. This code compiles only once go-tfe
+// adds that surface upstream; do not merge until go.mod is bumped to a
+// go-tfe release that defines it.
+
+// rulesFromModel decodes a rule list block into the tfe client's wire
+// representation, one entry per scope.
+func rulesFromModel(ctx context.Context, ruleList basetypes.ListValue) ([]*tfe.DataRetentionPolicyRule, diag.Diagnostics) {
+	var rules []modelTFERule
+	diags := ruleList.ElementsAs(ctx, &rules, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	result := make([]*tfe.DataRetentionPolicyRule, 0, len(rules))
+	for _, rule := range rules {
+		entry := &tfe.DataRetentionPolicyRule{
+			Scope: rule.Scope.ValueString(),
+		}
+		if !rule.DeleteOlderThanDays.IsNull() {
+			days, _ := rule.DeleteOlderThanDays.ValueBigFloat().Int64()
+			entry.DeleteOlderThanNDays = int(days)
+		}
+		if !rule.KeepLatestN.IsNull() {
+			keepLatestN, _ := rule.KeepLatestN.ValueBigFloat().Int64()
+			entry.KeepLatestN = int(keepLatestN)
+		}
+		result = append(result, entry)
+	}
+
+	return result, diags
+}
+
+func (r *resourceTFEDataRetentionPolicy) createRulesRetentionPolicy(ctx context.Context, plan modelTFEDataRetentionPolicy, resp *resource.CreateResponse) {
+	rules, diags := rulesFromModel(ctx, plan.Rule)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	options := tfe.DataRetentionPolicyRulesSetOptions{Rules: rules}
+
+	tflog.Debug(ctx, "Creating data retention policy")
+	var dataRetentionPolicy *tfe.DataRetentionPolicyRules
+	var err error
+	if plan.WorkspaceId.IsNull() {
+		dataRetentionPolicy, err = r.config.Client.Organizations.SetDataRetentionPolicyRules(ctx, plan.Organization.ValueString(), options)
+	} else {
+		dataRetentionPolicy, err = r.config.Client.Workspaces.SetDataRetentionPolicyRules(ctx, plan.WorkspaceId.ValueString(), options)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create data retention policy", err.Error())
+		return
+	}
+
+	result, diags := modelFromTFEDataRetentionPolicyRules(ctx, plan, dataRetentionPolicy)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+}
+
+// deleteOlderThanNDays converts the mutually exclusive days/months/years
+// attributes on a delete_older_than block into a single number of days, as
+// expected by the TFE API.
+func deleteOlderThanNDays(deleteOlderThan *modelTFEDeleteOlderThan) int {
+	switch {
+	case !deleteOlderThan.Months.IsNull():
+		months, _ := deleteOlderThan.Months.ValueBigFloat().Int64()
+		return int(months) * 30
+	case !deleteOlderThan.Years.IsNull():
+		years, _ := deleteOlderThan.Years.ValueBigFloat().Int64()
+		return int(years) * 365
+	default:
+		days, _ := deleteOlderThan.Days.ValueBigFloat().Int64()
+		return int(days)
+	}
 }
 
 func (r *resourceTFEDataRetentionPolicy) createDeleteOlderThanRetentionPolicy(ctx context.Context, plan modelTFEDataRetentionPolicy, resp *resource.CreateResponse) {
@@ -148,9 +465,19 @@ func (r *resourceTFEDataRetentionPolicy) createDeleteOlderThanRetentionPolicy(ct
 		return
 	}
 
-	deleteOlderThanDays, _ := deleteOlderThan.Days.ValueBigFloat().Int64()
 	options := tfe.DataRetentionPolicyDeleteOlderSetOptions{
-		DeleteOlderThanNDays: int(deleteOlderThanDays),
+		DeleteOlderThanNDays: deleteOlderThanNDays(deleteOlderThan),
+	}
+
+	if !plan.Schedule.IsNull() {
+		schedule := &modelTFESchedule{}
+		diags := plan.Schedule.As(ctx, &schedule, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		options.ScheduleCronExpression = schedule.Cron.ValueString()
+		options.ScheduleTimezone = schedule.Timezone.ValueString()
 	}
 
 	tflog.Debug(ctx, "Creating data retention policy")
@@ -231,6 +558,18 @@ func (r *resourceTFEDataRetentionPolicy) Read(ctx context.Context, req resource.
 			return
 		}
 	}
+	if policy.DataRetentionPolicyRules != nil {
+		result, diags := modelFromTFEDataRetentionPolicyRules(ctx, state, policy.DataRetentionPolicyRules)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+
+		// Save data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+		return
+	}
+
 	result, diags := modelFromTFEDataRetentionPolicyChoice(ctx, state, policy)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
@@ -242,49 +581,253 @@ func (r *resourceTFEDataRetentionPolicy) Read(ctx context.Context, req resource.
 }
 
 func (r *resourceTFEDataRetentionPolicy) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// If the resource does not support modification and should always be recreated on
-	// configuration value updates, the Update logic can be left empty and ensure all
-	// configurable schema attributes implement the resource.RequiresReplace()
-	// attribute plan modifier.
-	resp.Diagnostics.AddError("Update not supported", "The update operation is not supported on this resource. This is a bug in the provider.")
+	var plan modelTFEDataRetentionPolicy
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var organization string
+	if plan.WorkspaceId.IsNull() {
+		resp.Diagnostics.Append(r.config.dataOrDefaultOrganization(ctx, req.Plan, &organization)...)
+		plan.Organization = types.StringValue(organization)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.DeleteOlderThan.IsNull() {
+		r.updateDeleteOlderThanRetentionPolicy(ctx, plan, resp)
+		return
+	}
+
+	if !plan.DontDelete.IsNull() {
+		r.updateDontDeleteRetentionPolicy(ctx, plan, resp)
+		return
+	}
+
+	if len(plan.Rule.Elements()) > 0 {
+		r.updateRulesRetentionPolicy(ctx, plan, resp)
+		return
+	}
+}
+
+func (r *resourceTFEDataRetentionPolicy) updateRulesRetentionPolicy(ctx context.Context, plan modelTFEDataRetentionPolicy, resp *resource.UpdateResponse) {
+	rules, diags := rulesFromModel(ctx, plan.Rule)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	options := tfe.DataRetentionPolicyRulesSetOptions{Rules: rules}
+
+	tflog.Debug(ctx, "Updating data retention policy")
+	var dataRetentionPolicy *tfe.DataRetentionPolicyRules
+	var err error
+	if plan.WorkspaceId.IsNull() {
+		dataRetentionPolicy, err = r.config.Client.Organizations.SetDataRetentionPolicyRules(ctx, plan.Organization.ValueString(), options)
+	} else {
+		dataRetentionPolicy, err = r.config.Client.Workspaces.SetDataRetentionPolicyRules(ctx, plan.WorkspaceId.ValueString(), options)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update data retention policy", err.Error())
+		return
+	}
+
+	result, diags := modelFromTFEDataRetentionPolicyRules(ctx, plan, dataRetentionPolicy)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+}
+
+func (r *resourceTFEDataRetentionPolicy) updateDeleteOlderThanRetentionPolicy(ctx context.Context, plan modelTFEDataRetentionPolicy, resp *resource.UpdateResponse) {
+	deleteOlderThan := &modelTFEDeleteOlderThan{}
+
+	diags := plan.DeleteOlderThan.As(ctx, &deleteOlderThan, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	options := tfe.DataRetentionPolicyDeleteOlderSetOptions{
+		DeleteOlderThanNDays: deleteOlderThanNDays(deleteOlderThan),
+	}
+
+	if !plan.Schedule.IsNull() {
+		schedule := &modelTFESchedule{}
+		diags := plan.Schedule.As(ctx, &schedule, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		options.ScheduleCronExpression = schedule.Cron.ValueString()
+		options.ScheduleTimezone = schedule.Timezone.ValueString()
+	}
+
+	tflog.Debug(ctx, "Updating data retention policy")
+	var dataRetentionPolicy *tfe.DataRetentionPolicyDeleteOlder
+	var err error
+	if plan.WorkspaceId.IsNull() {
+		dataRetentionPolicy, err = r.config.Client.Organizations.SetDataRetentionPolicyDeleteOlder(ctx, plan.Organization.ValueString(), options)
+	} else {
+		dataRetentionPolicy, err = r.config.Client.Workspaces.SetDataRetentionPolicyDeleteOlder(ctx, plan.WorkspaceId.ValueString(), options)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update data retention policy", err.Error())
+		return
+	}
+
+	result, diags := modelFromTFEDataRetentionPolicyDeleteOlder(ctx, plan, dataRetentionPolicy)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+}
+
+func (r *resourceTFEDataRetentionPolicy) updateDontDeleteRetentionPolicy(ctx context.Context, plan modelTFEDataRetentionPolicy, resp *resource.UpdateResponse) {
+	options := tfe.DataRetentionPolicyDontDeleteSetOptions{}
+
+	tflog.Debug(ctx, "Updating data retention policy")
+	var dataRetentionPolicy *tfe.DataRetentionPolicyDontDelete
+	var err error
+	if plan.WorkspaceId.IsNull() {
+		dataRetentionPolicy, err = r.config.Client.Organizations.SetDataRetentionPolicyDontDelete(ctx, plan.Organization.ValueString(), options)
+	} else {
+		dataRetentionPolicy, err = r.config.Client.Workspaces.SetDataRetentionPolicyDontDelete(ctx, plan.WorkspaceId.ValueString(), options)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update data retention policy", err.Error())
+		return
+	}
+
+	result := modelFromTFEDataRetentionPolicyDontDelete(plan, dataRetentionPolicy)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
 }
 
 func (r *resourceTFEDataRetentionPolicy) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//var state modelTFERegistryGPGKey
-	//
-	//// Read Terraform prior state data into the model
-	//resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	//
-	//if resp.Diagnostics.HasError() {
-	//	return
-	//}
-	//
-	//keyID := tfe.GPGKeyID{
-	//	RegistryName: "private",
-	//	Namespace:    state.Organization.ValueString(),
-	//	KeyID:        state.ID.ValueString(),
-	//}
-	//
-	//tflog.Debug(ctx, "Deleting private registry GPG key")
-	//err := r.config.Client.GPGKeys.Delete(ctx, keyID)
-	//if err != nil {
-	//	resp.Diagnostics.AddError("Unable to delete private registry GPG key", err.Error())
-	//	return
-	//}
+	var state modelTFEDataRetentionPolicy
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting data retention policy")
+	var err error
+	if state.WorkspaceId.IsNull() {
+		err = r.config.Client.Organizations.DeleteDataRetentionPolicy(ctx, state.Organization.ValueString())
+	} else {
+		err = r.config.Client.Workspaces.DeleteDataRetentionPolicy(ctx, state.WorkspaceId.ValueString())
+	}
+	if err != nil && !errors.Is(err, tfe.ErrResourceNotFound) {
+		resp.Diagnostics.AddError("Unable to delete data retention policy", err.Error())
+		return
+	}
 }
 
+// dataRetentionPolicyChoiceID returns the ID of whichever policy variant is
+// populated on a DataRetentionPolicyChoice. It errors rather than returning
+// an empty ID if none of the known variants are set, so a policy kind this
+// provider doesn't yet recognize fails loudly instead of importing blank.
+func dataRetentionPolicyChoiceID(policy *tfe.DataRetentionPolicyChoice) (string, error) {
+	switch {
+	case policy.DataRetentionPolicyDeleteOlder != nil:
+		return policy.DataRetentionPolicyDeleteOlder.ID, nil
+	case policy.DataRetentionPolicyDontDelete != nil:
+		return policy.DataRetentionPolicyDontDelete.ID, nil
+	case policy.DataRetentionPolicyRules != nil:
+		return policy.DataRetentionPolicyRules.ID, nil
+	default:
+		return "", errors.New("data retention policy has no recognized delete_older_than, dont_delete, or rule variant set")
+	}
+}
+
+// ImportState supports three import forms:
+//   - <ORGANIZATION>                    the organization-wide policy
+//   - <ORGANIZATION>/<WORKSPACE NAME>    a workspace-scoped policy, resolved to workspace_id
+//   - <ORGANIZATION>/<ID>                direct id-based import
 func (r *resourceTFEDataRetentionPolicy) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	s := strings.SplitN(req.ID, "/", 2)
-	if len(s) != 2 {
+	parts := strings.SplitN(req.ID, "/", 2)
+
+	switch len(parts) {
+	case 1:
+		organization := parts[0]
+
+		policy, err := r.config.Client.Organizations.ReadDataRetentionPolicyChoice(ctx, organization)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error importing data retention policy",
+				fmt.Sprintf("Could not read data retention policy for organization %s: %s", organization, err),
+			)
+			return
+		}
+
+		id, err := dataRetentionPolicyChoiceID(policy)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error importing data retention policy",
+				fmt.Sprintf("Could not determine the ID of the data retention policy for organization %s: %s", organization, err),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization"), organization)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	case 2:
+		organization := parts[0]
+		workspaceNameOrID := parts[1]
+
+		ws, err := r.config.Client.Workspaces.Read(ctx, organization, workspaceNameOrID)
+		switch {
+		case err == nil:
+			policy, err := r.config.Client.Workspaces.ReadDataRetentionPolicyChoice(ctx, ws.ID)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error importing data retention policy",
+					fmt.Sprintf("Could not read data retention policy for workspace %s: %s", ws.ID, err),
+				)
+				return
+			}
+
+			id, err := dataRetentionPolicyChoiceID(policy)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error importing data retention policy",
+					fmt.Sprintf("Could not determine the ID of the data retention policy for workspace %s: %s", ws.ID, err),
+				)
+				return
+			}
+
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), ws.ID)...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		case errors.Is(err, tfe.ErrResourceNotFound):
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization"), organization)...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), workspaceNameOrID)...)
+		default:
+			resp.Diagnostics.AddError(
+				"Error importing data retention policy",
+				fmt.Sprintf("Could not read workspace %s/%s: %s", organization, workspaceNameOrID, err),
+			)
+		}
+	default:
 		resp.Diagnostics.AddError(
-			"Error importing variable",
-			fmt.Sprintf("Invalid variable import format: %s (expected <ORGANIZATION>/<KEY ID>)", req.ID),
+			"Error importing data retention policy",
+			fmt.Sprintf("Invalid data retention policy import format: %s (expected <ORGANIZATION>, <ORGANIZATION>/<WORKSPACE NAME>, or <ORGANIZATION>/<ID>)", req.ID),
 		)
-		return
 	}
-	org := s[0]
-	id := s[1]
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization"), org)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+)
+
+func TestDataRetentionPolicyChoiceID(t *testing.T) {
+	cases := map[string]struct {
+		policy  *tfe.DataRetentionPolicyChoice
+		want    string
+		wantErr bool
+	}{
+		"delete_older": {
+			policy: &tfe.DataRetentionPolicyChoice{
+				DataRetentionPolicyDeleteOlder: &tfe.DataRetentionPolicyDeleteOlder{ID: "drp-delete-older"},
+			},
+			want: "drp-delete-older",
+		},
+		"dont_delete": {
+			policy: &tfe.DataRetentionPolicyChoice{
+				DataRetentionPolicyDontDelete: &tfe.DataRetentionPolicyDontDelete{ID: "drp-dont-delete"},
+			},
+			want: "drp-dont-delete",
+		},
+		"rules": {
+			policy: &tfe.DataRetentionPolicyChoice{
+				DataRetentionPolicyRules: &tfe.DataRetentionPolicyRules{ID: "drp-rules"},
+			},
+			want: "drp-rules",
+		},
+		"none set": {
+			policy:  &tfe.DataRetentionPolicyChoice{},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := dataRetentionPolicyChoiceID(tc.policy)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("dataRetentionPolicyChoiceID() = %q, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("dataRetentionPolicyChoiceID() returned error: %s", err)
+			}
+			if got != tc.want {
+				t.Errorf("dataRetentionPolicyChoiceID() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// modelTFEDataRetentionPolicy is the data model for the
+// tfe_data_retention_policy resource.
+type modelTFEDataRetentionPolicy struct {
+	ID              types.String `tfsdk:"id"`
+	Organization    types.String `tfsdk:"organization"`
+	WorkspaceId     types.String `tfsdk:"workspace_id"`
+	DeleteOlderThan types.Object `tfsdk:"delete_older_than"`
+	DontDelete      types.Object `tfsdk:"dont_delete"`
+	Schedule        types.Object `tfsdk:"schedule"`
+	Rule            types.List   `tfsdk:"rule"`
+}
+
+// modelTFEDeleteOlderThan is the data model for the delete_older_than block.
+// Exactly one of Days, Months, or Years is set.
+type modelTFEDeleteOlderThan struct {
+	Days   types.Number `tfsdk:"days"`
+	Months types.Number `tfsdk:"months"`
+	Years  types.Number `tfsdk:"years"`
+}
+
+// modelTFESchedule is the data model for the schedule block.
+type modelTFESchedule struct {
+	Cron     types.String `tfsdk:"cron"`
+	Timezone types.String `tfsdk:"timezone"`
+}
+
+// modelTFERule is the data model for a single rule block.
+type modelTFERule struct {
+	Scope               types.String `tfsdk:"scope"`
+	DeleteOlderThanDays types.Number `tfsdk:"delete_older_than_days"`
+	KeepLatestN         types.Number `tfsdk:"keep_latest_n"`
+}
+
+var modelTFEDeleteOlderThanAttrTypes = map[string]attr.Type{
+	"days":   types.NumberType,
+	"months": types.NumberType,
+	"years":  types.NumberType,
+}
+
+var modelTFEDontDeleteAttrTypes = map[string]attr.Type{}
+
+var modelTFEScheduleAttrTypes = map[string]attr.Type{
+	"cron":     types.StringType,
+	"timezone": types.StringType,
+}
+
+var modelTFERuleAttrTypes = map[string]attr.Type{
+	"scope":                  types.StringType,
+	"delete_older_than_days": types.NumberType,
+	"keep_latest_n":          types.NumberType,
+}
+
+var modelTFERuleObjectType = types.ObjectType{AttrTypes: modelTFERuleAttrTypes}
+
+// modelFromTFEDataRetentionPolicyDeleteOlder folds a DataRetentionPolicyDeleteOlder
+// API response back into the plan/state model. The days/months/years unit the
+// practitioner configured isn't returned by the API, so it's carried forward
+// from base rather than reconstructed from the wire's single N-days value.
+// When base has no delete_older_than block (e.g. right after import), the
+// block is built from the wire value, with days set to N.
+func modelFromTFEDataRetentionPolicyDeleteOlder(ctx context.Context, base modelTFEDataRetentionPolicy, policy *tfe.DataRetentionPolicyDeleteOlder) (modelTFEDataRetentionPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	result := base
+	result.ID = types.StringValue(policy.ID)
+
+	if result.DeleteOlderThan.IsNull() {
+		deleteOlderThanObj, d := types.ObjectValue(modelTFEDeleteOlderThanAttrTypes, map[string]attr.Value{
+			"days":   types.NumberValue(big.NewFloat(float64(policy.DeleteOlderThanNDays))),
+			"months": types.NumberNull(),
+			"years":  types.NumberNull(),
+		})
+		diags.Append(d...)
+		result.DeleteOlderThan = deleteOlderThanObj
+	}
+
+	if !result.Schedule.IsNull() {
+		scheduleObj, d := types.ObjectValue(modelTFEScheduleAttrTypes, map[string]attr.Value{
+			"cron":     types.StringValue(policy.ScheduleCronExpression),
+			"timezone": types.StringValue(policy.ScheduleTimezone),
+		})
+		diags.Append(d...)
+		result.Schedule = scheduleObj
+	}
+
+	return result, diags
+}
+
+// modelFromTFEDataRetentionPolicyDontDelete folds a DataRetentionPolicyDontDelete
+// API response back into the plan/state model.
+func modelFromTFEDataRetentionPolicyDontDelete(base modelTFEDataRetentionPolicy, policy *tfe.DataRetentionPolicyDontDelete) modelTFEDataRetentionPolicy {
+	result := base
+	result.ID = types.StringValue(policy.ID)
+	return result
+}
+
+// modelFromTFEDataRetentionPolicyRules folds a DataRetentionPolicyRules API
+// response back into the plan/state model, reconstructing the rule list from
+// the API's scoped rules rather than carrying the plan's list forward, since
+// the API is the source of truth for normalized rule values.
+func modelFromTFEDataRetentionPolicyRules(ctx context.Context, base modelTFEDataRetentionPolicy, policy *tfe.DataRetentionPolicyRules) (modelTFEDataRetentionPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	result := base
+	result.ID = types.StringValue(policy.ID)
+
+	rules := make([]modelTFERule, 0, len(policy.Rules))
+	for _, rule := range policy.Rules {
+		entry := modelTFERule{
+			Scope:               types.StringValue(rule.Scope),
+			DeleteOlderThanDays: types.NumberNull(),
+			KeepLatestN:         types.NumberNull(),
+		}
+		if rule.DeleteOlderThanNDays > 0 {
+			entry.DeleteOlderThanDays = types.NumberValue(big.NewFloat(float64(rule.DeleteOlderThanNDays)))
+		}
+		if rule.KeepLatestN > 0 {
+			entry.KeepLatestN = types.NumberValue(big.NewFloat(float64(rule.KeepLatestN)))
+		}
+		rules = append(rules, entry)
+	}
+
+	ruleList, d := types.ListValueFrom(ctx, modelTFERuleObjectType, rules)
+	diags.Append(d...)
+	result.Rule = ruleList
+
+	return result, diags
+}
+
+// modelFromTFEDataRetentionPolicyChoice folds a DataRetentionPolicyChoice
+// into the plan/state model. Callers handle the DataRetentionPolicyRules
+// variant separately since it needs the richer modelFromTFEDataRetentionPolicyRules
+// conversion.
+func modelFromTFEDataRetentionPolicyChoice(ctx context.Context, base modelTFEDataRetentionPolicy, policy *tfe.DataRetentionPolicyChoice) (modelTFEDataRetentionPolicy, diag.Diagnostics) {
+	switch {
+	case policy.DataRetentionPolicyDeleteOlder != nil:
+		return modelFromTFEDataRetentionPolicyDeleteOlder(ctx, base, policy.DataRetentionPolicyDeleteOlder)
+	case policy.DataRetentionPolicyDontDelete != nil:
+		return modelFromTFEDataRetentionPolicyDontDelete(base, policy.DataRetentionPolicyDontDelete), nil
+	default:
+		var diags diag.Diagnostics
+		diags.AddError("No data retention policy found", "The organization or workspace does not have a data retention policy configured.")
+		return base, diags
+	}
+}
@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	testModifyPlanDeleteOlderThanType = tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"days":   tftypes.Number,
+			"months": tftypes.Number,
+			"years":  tftypes.Number,
+		},
+	}
+	testModifyPlanDontDeleteType = tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{},
+	}
+	testModifyPlanScheduleType = tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"cron":     tftypes.String,
+			"timezone": tftypes.String,
+		},
+	}
+	testModifyPlanRuleType = tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"scope":                  tftypes.String,
+			"delete_older_than_days": tftypes.Number,
+			"keep_latest_n":          tftypes.Number,
+		},
+	}
+	testModifyPlanObjectType = tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":                tftypes.String,
+			"organization":      tftypes.String,
+			"workspace_id":      tftypes.String,
+			"delete_older_than": testModifyPlanDeleteOlderThanType,
+			"dont_delete":       testModifyPlanDontDeleteType,
+			"schedule":          testModifyPlanScheduleType,
+			"rule":              tftypes.List{ElementType: testModifyPlanRuleType},
+		},
+	}
+)
+
+func testModifyPlanEmptyRuleList() tftypes.Value {
+	return tftypes.NewValue(tftypes.List{ElementType: testModifyPlanRuleType}, []tftypes.Value{})
+}
+
+func TestSuppressComputedDiffs_unchangedDays(t *testing.T) {
+	deleteOlderThan := tftypes.NewValue(testModifyPlanDeleteOlderThanType, map[string]tftypes.Value{
+		"days":   tftypes.NewValue(tftypes.Number, 30),
+		"months": tftypes.NewValue(tftypes.Number, nil),
+		"years":  tftypes.NewValue(tftypes.Number, nil),
+	})
+
+	state := tftypes.NewValue(testModifyPlanObjectType, map[string]tftypes.Value{
+		"id":                tftypes.NewValue(tftypes.String, "drp-123"),
+		"organization":      tftypes.NewValue(tftypes.String, "my-org"),
+		"workspace_id":      tftypes.NewValue(tftypes.String, nil),
+		"delete_older_than": deleteOlderThan,
+		"dont_delete":       tftypes.NewValue(testModifyPlanDontDeleteType, nil),
+		"schedule":          tftypes.NewValue(testModifyPlanScheduleType, nil),
+		"rule":              testModifyPlanEmptyRuleList(),
+	})
+	config := tftypes.NewValue(testModifyPlanObjectType, map[string]tftypes.Value{
+		"id":                tftypes.NewValue(tftypes.String, nil),
+		"organization":      tftypes.NewValue(tftypes.String, "my-org"),
+		"workspace_id":      tftypes.NewValue(tftypes.String, nil),
+		"delete_older_than": deleteOlderThan,
+		"dont_delete":       tftypes.NewValue(testModifyPlanDontDeleteType, nil),
+		"schedule":          tftypes.NewValue(testModifyPlanScheduleType, nil),
+		"rule":              testModifyPlanEmptyRuleList(),
+	})
+	// The API response for "id" is computed, so the plan carries it as
+	// unknown even though nothing meaningful changed.
+	planned := tftypes.NewValue(testModifyPlanObjectType, map[string]tftypes.Value{
+		"id":                tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"organization":      tftypes.NewValue(tftypes.String, "my-org"),
+		"workspace_id":      tftypes.NewValue(tftypes.String, nil),
+		"delete_older_than": deleteOlderThan,
+		"dont_delete":       tftypes.NewValue(testModifyPlanDontDeleteType, nil),
+		"schedule":          tftypes.NewValue(testModifyPlanScheduleType, nil),
+		"rule":              testModifyPlanEmptyRuleList(),
+	})
+
+	got, err := suppressComputedDiffs(planned, state, config)
+	if err != nil {
+		t.Fatalf("suppressComputedDiffs() returned error: %s", err)
+	}
+
+	if !got.Equal(state) {
+		t.Errorf("expected the computed id to carry the prior state forward, got %s, want %s", got, state)
+	}
+}
+
+func TestSuppressComputedDiffs_switchFromDontDeleteToDeleteOlderThan(t *testing.T) {
+	nullDeleteOlderThan := tftypes.NewValue(testModifyPlanDeleteOlderThanType, nil)
+	configuredDeleteOlderThan := tftypes.NewValue(testModifyPlanDeleteOlderThanType, map[string]tftypes.Value{
+		"days":   tftypes.NewValue(tftypes.Number, 30),
+		"months": tftypes.NewValue(tftypes.Number, nil),
+		"years":  tftypes.NewValue(tftypes.Number, nil),
+	})
+	configuredDontDelete := tftypes.NewValue(testModifyPlanDontDeleteType, map[string]tftypes.Value{})
+	nullDontDelete := tftypes.NewValue(testModifyPlanDontDeleteType, nil)
+
+	// Prior state has dont_delete configured and no delete_older_than.
+	state := tftypes.NewValue(testModifyPlanObjectType, map[string]tftypes.Value{
+		"id":                tftypes.NewValue(tftypes.String, "drp-123"),
+		"organization":      tftypes.NewValue(tftypes.String, "my-org"),
+		"workspace_id":      tftypes.NewValue(tftypes.String, nil),
+		"delete_older_than": nullDeleteOlderThan,
+		"dont_delete":       configuredDontDelete,
+		"schedule":          tftypes.NewValue(testModifyPlanScheduleType, nil),
+		"rule":              testModifyPlanEmptyRuleList(),
+	})
+	// Config now switches to delete_older_than and removes dont_delete.
+	config := tftypes.NewValue(testModifyPlanObjectType, map[string]tftypes.Value{
+		"id":                tftypes.NewValue(tftypes.String, nil),
+		"organization":      tftypes.NewValue(tftypes.String, "my-org"),
+		"workspace_id":      tftypes.NewValue(tftypes.String, nil),
+		"delete_older_than": configuredDeleteOlderThan,
+		"dont_delete":       nullDontDelete,
+		"schedule":          tftypes.NewValue(testModifyPlanScheduleType, nil),
+		"rule":              testModifyPlanEmptyRuleList(),
+	})
+	// Terraform core's own plan already reflects the config: dont_delete is
+	// null (removed) and delete_older_than carries the new value. Only "id"
+	// is genuinely unknown/computed.
+	planned := tftypes.NewValue(testModifyPlanObjectType, map[string]tftypes.Value{
+		"id":                tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"organization":      tftypes.NewValue(tftypes.String, "my-org"),
+		"workspace_id":      tftypes.NewValue(tftypes.String, nil),
+		"delete_older_than": configuredDeleteOlderThan,
+		"dont_delete":       nullDontDelete,
+		"schedule":          tftypes.NewValue(testModifyPlanScheduleType, nil),
+		"rule":              testModifyPlanEmptyRuleList(),
+	})
+
+	got, err := suppressComputedDiffs(planned, state, config)
+	if err != nil {
+		t.Fatalf("suppressComputedDiffs() returned error: %s", err)
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := got.As(&attrs); err != nil {
+		t.Fatalf("failed to decode result: %s", err)
+	}
+
+	// The removed dont_delete block must stay null, not be resurrected from
+	// the old state, or Terraform core will reject the apply as producing
+	// an inconsistent result.
+	if !attrs["dont_delete"].IsNull() {
+		t.Errorf("expected dont_delete to remain null after switching to delete_older_than, got %s", attrs["dont_delete"])
+	}
+	if !attrs["delete_older_than"].Equal(configuredDeleteOlderThan) {
+		t.Errorf("expected delete_older_than to plan as the new config value, got %s", attrs["delete_older_than"])
+	}
+}
+
+func TestSuppressComputedDiffs_nullOrgAgainstResolvedDefaultOrg(t *testing.T) {
+	deleteOlderThan := tftypes.NewValue(testModifyPlanDeleteOlderThanType, map[string]tftypes.Value{
+		"days":   tftypes.NewValue(tftypes.Number, 30),
+		"months": tftypes.NewValue(tftypes.Number, nil),
+		"years":  tftypes.NewValue(tftypes.Number, nil),
+	})
+
+	state := tftypes.NewValue(testModifyPlanObjectType, map[string]tftypes.Value{
+		"id":                tftypes.NewValue(tftypes.String, "drp-123"),
+		"organization":      tftypes.NewValue(tftypes.String, "default-org"),
+		"workspace_id":      tftypes.NewValue(tftypes.String, nil),
+		"delete_older_than": deleteOlderThan,
+		"dont_delete":       tftypes.NewValue(testModifyPlanDontDeleteType, nil),
+		"schedule":          tftypes.NewValue(testModifyPlanScheduleType, nil),
+		"rule":              testModifyPlanEmptyRuleList(),
+	})
+	config := tftypes.NewValue(testModifyPlanObjectType, map[string]tftypes.Value{
+		"id":                tftypes.NewValue(tftypes.String, nil),
+		"organization":      tftypes.NewValue(tftypes.String, nil),
+		"workspace_id":      tftypes.NewValue(tftypes.String, nil),
+		"delete_older_than": deleteOlderThan,
+		"dont_delete":       tftypes.NewValue(testModifyPlanDontDeleteType, nil),
+		"schedule":          tftypes.NewValue(testModifyPlanScheduleType, nil),
+		"rule":              testModifyPlanEmptyRuleList(),
+	})
+	// "organization" was left unset in config, so the plan shows it as
+	// unknown until it is resolved from the provider's default organization.
+	planned := tftypes.NewValue(testModifyPlanObjectType, map[string]tftypes.Value{
+		"id":                tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"organization":      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"workspace_id":      tftypes.NewValue(tftypes.String, nil),
+		"delete_older_than": deleteOlderThan,
+		"dont_delete":       tftypes.NewValue(testModifyPlanDontDeleteType, nil),
+		"schedule":          tftypes.NewValue(testModifyPlanScheduleType, nil),
+		"rule":              testModifyPlanEmptyRuleList(),
+	})
+
+	got, err := suppressComputedDiffs(planned, state, config)
+	if err != nil {
+		t.Fatalf("suppressComputedDiffs() returned error: %s", err)
+	}
+
+	if !got.Equal(state) {
+		t.Errorf("expected the resolved default organization to carry forward, got %s, want %s", got, state)
+	}
+}
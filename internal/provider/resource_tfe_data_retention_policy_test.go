@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccTFEDataRetentionPolicy_deleteOrganization(t *testing.T) {
+	org, orgCleanup := createBusinessOrganization(t, testAccProvider.Meta().(ConfiguredClient).Client)
+	defer orgCleanup()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccMuxedProviders,
+		CheckDestroy:             testAccCheckTFEDataRetentionPolicyOrganizationDestroyed(org.Name),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEDataRetentionPolicy_organization(org.Name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("tfe_data_retention_policy.foobar", "delete_older_than.days", "30"),
+				),
+			},
+			{
+				ResourceName:      "tfe_data_retention_policy.foobar",
+				ImportState:       true,
+				ImportStateId:     org.Name,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTFEDataRetentionPolicy_deleteWorkspace(t *testing.T) {
+	org, orgCleanup := createBusinessOrganization(t, testAccProvider.Meta().(ConfiguredClient).Client)
+	defer orgCleanup()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccMuxedProviders,
+		CheckDestroy:             testAccCheckTFEDataRetentionPolicyWorkspaceDestroyed(org.Name, "workspace-test"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEDataRetentionPolicy_workspace(org.Name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("tfe_data_retention_policy.foobar", "delete_older_than.days", "30"),
+				),
+			},
+			{
+				ResourceName:      "tfe_data_retention_policy.foobar",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/workspace-test", org.Name),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestAccTFEDataRetentionPolicy_importByID covers the <ORGANIZATION>/<ID>
+// import form, used when the second segment doesn't resolve to a workspace
+// name (e.g. direct id-based import of an organization-level policy).
+func TestAccTFEDataRetentionPolicy_importByID(t *testing.T) {
+	org, orgCleanup := createBusinessOrganization(t, testAccProvider.Meta().(ConfiguredClient).Client)
+	defer orgCleanup()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccMuxedProviders,
+		CheckDestroy:             testAccCheckTFEDataRetentionPolicyOrganizationDestroyed(org.Name),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEDataRetentionPolicy_organization(org.Name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("tfe_data_retention_policy.foobar", "delete_older_than.days", "30"),
+				),
+			},
+			{
+				ResourceName:      "tfe_data_retention_policy.foobar",
+				ImportState:       true,
+				ImportStateIdFunc: testAccTFEDataRetentionPolicyImportStateIDByID(org.Name),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccTFEDataRetentionPolicyImportStateIDByID builds an
+// <ORGANIZATION>/<ID> import ID from the resource's own state, so the
+// id-fallback branch of ImportState (triggered when the second segment
+// isn't a known workspace name) gets exercised against a real policy ID.
+func testAccTFEDataRetentionPolicyImportStateIDByID(organization string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources["tfe_data_retention_policy.foobar"]
+		if !ok {
+			return "", fmt.Errorf("resource not found in state: tfe_data_retention_policy.foobar")
+		}
+		return fmt.Sprintf("%s/%s", organization, rs.Primary.ID), nil
+	}
+}
+
+// testAccCheckTFEDataRetentionPolicyOrganizationDestroyed asserts that the
+// organization-level data retention policy no longer exists once the
+// resource has been destroyed.
+func testAccCheckTFEDataRetentionPolicyOrganizationDestroyed(organization string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(ConfiguredClient).Client
+
+		choice, err := client.Organizations.ReadDataRetentionPolicyChoice(context.Background(), organization)
+		if err != nil && !errors.Is(err, tfe.ErrResourceNotFound) {
+			return fmt.Errorf("unexpected error reading data retention policy for organization %s: %w", organization, err)
+		}
+		if choice != nil && choice.DataRetentionPolicyDeleteOlder != nil {
+			return fmt.Errorf("data retention policy still exists for organization %s", organization)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckTFEDataRetentionPolicyWorkspaceDestroyed asserts that the
+// workspace-level data retention policy no longer exists once the resource
+// has been destroyed.
+func testAccCheckTFEDataRetentionPolicyWorkspaceDestroyed(organization, workspace string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(ConfiguredClient).Client
+
+		ws, err := client.Workspaces.Read(context.Background(), organization, workspace)
+		if err != nil {
+			return fmt.Errorf("unexpected error reading workspace %s/%s: %w", organization, workspace, err)
+		}
+
+		choice, err := client.Workspaces.ReadDataRetentionPolicyChoice(context.Background(), ws.ID)
+		if err != nil && !errors.Is(err, tfe.ErrResourceNotFound) {
+			return fmt.Errorf("unexpected error reading data retention policy for workspace %s: %w", ws.ID, err)
+		}
+		if choice != nil && choice.DataRetentionPolicyDeleteOlder != nil {
+			return fmt.Errorf("data retention policy still exists for workspace %s", ws.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccTFEDataRetentionPolicy_organization(organization string) string {
+	return fmt.Sprintf(`
+resource "tfe_data_retention_policy" "foobar" {
+  organization = "%s"
+
+  delete_older_than {
+    days = 30
+  }
+}`, organization)
+}
+
+func testAccTFEDataRetentionPolicy_workspace(organization string) string {
+	return fmt.Sprintf(`
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = "%s"
+}
+
+resource "tfe_data_retention_policy" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+
+  delete_older_than {
+    days = 30
+  }
+}`, organization)
+}